@@ -0,0 +1,139 @@
+package fandango
+
+import (
+	"context"
+	"errors"
+)
+
+// Backend abstracts the upstream movie-metadata provider that powers
+// Client's list methods. fandango ships two implementations:
+// TMDbBackend, the default, and RottenTomatoesBackend, kept only for
+// integrations that still have a working Rotten Tomatoes API key.
+type Backend interface {
+	// Name identifies the backend, primarily for logging/debugging.
+	Name() string
+
+	// UpcomingMovies streams pages of upcoming movies for query, closing
+	// the returned channel once the last page has been sent.
+	UpcomingMovies(c *Client, query *UpcomingMovieSearch) (<-chan UpcomingMoviesResult, error)
+
+	// UpcomingMoviesContext is like UpcomingMovies but cancels in-flight
+	// requests as soon as ctx is done, instead of only between ticks.
+	UpcomingMoviesContext(ctx context.Context, c *Client, query *UpcomingMovieSearch) (<-chan UpcomingMoviesResult, error)
+}
+
+// listBackend is implemented by backends that expose "now playing",
+// "popular" and "top rated" lists in addition to upcoming movies.
+// RottenTomatoesBackend doesn't implement it, so Client.NowPlaying et al.
+// report errUnsupportedByBackend when it is the configured backend.
+type listBackend interface {
+	NowPlaying(c *Client, query *UpcomingMovieSearch) (<-chan UpcomingMoviesResult, error)
+	NowPlayingContext(ctx context.Context, c *Client, query *UpcomingMovieSearch) (<-chan UpcomingMoviesResult, error)
+	Popular(c *Client, query *UpcomingMovieSearch) (<-chan UpcomingMoviesResult, error)
+	PopularContext(ctx context.Context, c *Client, query *UpcomingMovieSearch) (<-chan UpcomingMoviesResult, error)
+	TopRated(c *Client, query *UpcomingMovieSearch) (<-chan UpcomingMoviesResult, error)
+	TopRatedContext(ctx context.Context, c *Client, query *UpcomingMovieSearch) (<-chan UpcomingMoviesResult, error)
+}
+
+var errUnsupportedByBackend = errors.New("fandango: not supported by the configured backend")
+
+// SetBackend selects the Backend that Client's list methods talk to.
+func (c *Client) SetBackend(b Backend) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.backend = b
+}
+
+// Backend returns the Client's configured Backend, defaulting to a
+// TMDbBackend when none has been set.
+func (c *Client) Backend() Backend {
+	c.RLock()
+	defer c.RUnlock()
+
+	if c.backend == nil {
+		return new(TMDbBackend)
+	}
+
+	return c.backend
+}
+
+// NewDefaultClientWithBackend is like NewDefaultClient but also sets the
+// Client's Backend, e.g. to opt into the legacy RottenTomatoesBackend:
+//
+//	client, err := fandango.NewDefaultClientWithBackend(new(fandango.RottenTomatoesBackend))
+func NewDefaultClientWithBackend(backend Backend, apiKeysToTry ...string) (*Client, error) {
+	client, err := NewDefaultClient(apiKeysToTry...)
+	if err != nil {
+		return nil, err
+	}
+
+	client.SetBackend(backend)
+	return client, nil
+}
+
+// NowPlaying streams pages of movies currently in theaters. It requires a
+// backend that implements listBackend (TMDbBackend does).
+func (c *Client) NowPlaying(query *UpcomingMovieSearch) (<-chan UpcomingMoviesResult, error) {
+	return c.NowPlayingContext(context.Background(), query)
+}
+
+// NowPlayingContext is like NowPlaying but cancels in-flight requests as
+// soon as ctx is done.
+func (c *Client) NowPlayingContext(ctx context.Context, query *UpcomingMovieSearch) (<-chan UpcomingMoviesResult, error) {
+	apiKey := c.APIKey()
+	if apiKey == "" {
+		return nil, errEmptyAPIKey
+	}
+
+	lb, ok := c.Backend().(listBackend)
+	if !ok {
+		return nil, errUnsupportedByBackend
+	}
+
+	return lb.NowPlayingContext(ctx, c, query)
+}
+
+// Popular streams pages of the currently most popular movies. It requires
+// a backend that implements listBackend (TMDbBackend does).
+func (c *Client) Popular(query *UpcomingMovieSearch) (<-chan UpcomingMoviesResult, error) {
+	return c.PopularContext(context.Background(), query)
+}
+
+// PopularContext is like Popular but cancels in-flight requests as soon
+// as ctx is done.
+func (c *Client) PopularContext(ctx context.Context, query *UpcomingMovieSearch) (<-chan UpcomingMoviesResult, error) {
+	apiKey := c.APIKey()
+	if apiKey == "" {
+		return nil, errEmptyAPIKey
+	}
+
+	lb, ok := c.Backend().(listBackend)
+	if !ok {
+		return nil, errUnsupportedByBackend
+	}
+
+	return lb.PopularContext(ctx, c, query)
+}
+
+// TopRated streams pages of top rated movies. It requires a backend that
+// implements listBackend (TMDbBackend does).
+func (c *Client) TopRated(query *UpcomingMovieSearch) (<-chan UpcomingMoviesResult, error) {
+	return c.TopRatedContext(context.Background(), query)
+}
+
+// TopRatedContext is like TopRated but cancels in-flight requests as soon
+// as ctx is done.
+func (c *Client) TopRatedContext(ctx context.Context, query *UpcomingMovieSearch) (<-chan UpcomingMoviesResult, error) {
+	apiKey := c.APIKey()
+	if apiKey == "" {
+		return nil, errEmptyAPIKey
+	}
+
+	lb, ok := c.Backend().(listBackend)
+	if !ok {
+		return nil, errUnsupportedByBackend
+	}
+
+	return lb.TopRatedContext(ctx, c, query)
+}
@@ -0,0 +1,127 @@
+package fandango
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// RottenTomatoesBackend talks to the legacy Rotten Tomatoes public API.
+// Rotten Tomatoes shut this API down years ago; it is kept only for
+// integrations that still have a working (e.g. grandfathered or
+// self-hosted proxy) API key. New integrations should use TMDbBackend,
+// the default.
+type RottenTomatoesBackend struct{}
+
+func (*RottenTomatoesBackend) Name() string { return "rottentomatoes" }
+
+// http://api.rottentomatoes.com/api/public/v1.0/lists/movies/upcoming.json?apikey=[your_api_key]&page_limit=1
+const rtBaseURL = "http://api.rottentomatoes.com/api/public"
+
+func (rt *RottenTomatoesBackend) makeUpcomingMoviesURL(c *Client, q *UpcomingMovieSearch) (string, error) {
+	values := url.Values{
+		"apikey": []string{c.apiKey},
+	}
+	if q != nil {
+		if q.ItemsPerPage > 0 {
+			values.Set("page_limit", fmt.Sprintf("%d", q.ItemsPerPage))
+		}
+		if q.MaxPage > 0 {
+			values.Set("page", fmt.Sprintf("%d", q.MaxPage))
+		}
+		if q.Country != "" {
+			values.Set("country", q.Country)
+		}
+	}
+
+	fullURL := fmt.Sprintf("%s/v%s/lists/movies/upcoming.json?%s", rtBaseURL, c.APIVersion(), values.Encode())
+	return fullURL, nil
+}
+
+func (rt *RottenTomatoesBackend) UpcomingMovies(c *Client, query *UpcomingMovieSearch) (<-chan UpcomingMoviesResult, error) {
+	return rt.UpcomingMoviesContext(context.Background(), c, query)
+}
+
+func (rt *RottenTomatoesBackend) UpcomingMoviesContext(ctx context.Context, c *Client, query *UpcomingMovieSearch) (<-chan UpcomingMoviesResult, error) {
+	dataURL, err := rt.makeUpcomingMoviesURL(c, query)
+	// log.Printf("dataURL: %s err: %v\n", dataURL, err)
+	if err != nil {
+		return nil, err
+	}
+
+	var cancel <-chan struct{}
+	forceRefresh := false
+	if query != nil {
+		cancel = query.Cancel
+		forceRefresh = query.ForceRefresh
+	}
+
+	resultsChan := make(chan UpcomingMoviesResult)
+	go func() {
+		defer close(resultsChan)
+
+		// Pacing is governed by c.RateLimiter() inside cachedFetchContext,
+		// not a fixed ticker here, so SetRateLimit actually controls how
+		// fast pagination proceeds.
+		working := true
+		for working {
+			select {
+			case <-ctx.Done():
+				select {
+				case resultsChan <- UpcomingMoviesResult{Err: ctx.Err()}:
+				case <-ctx.Done():
+				}
+				return
+			case _, _ = <-cancel:
+				working = false
+				continue
+			default:
+			}
+
+			blob, err := c.cachedFetchContext(ctx, dataURL, forceRefresh)
+			// log.Printf("blob: %s err: %v\n", blob, err)
+			if err != nil {
+				select {
+				case resultsChan <- UpcomingMoviesResult{Err: err}:
+				case <-ctx.Done():
+				}
+				return
+			}
+			page, err := rt.parseUpcomingMoviesResponse(blob)
+			// log.Printf("page: %#v err: %v\n", page, err)
+			if err != nil {
+				select {
+				case resultsChan <- UpcomingMoviesResult{Err: err}:
+				case <-ctx.Done():
+				}
+				return
+			}
+
+			select {
+			case resultsChan <- UpcomingMoviesResult{Page: page}:
+			case <-ctx.Done():
+				return
+			}
+
+			// Set to the next page if we have one.
+			dataURL = page.Links.GetNextURL()
+			// log.Printf("next::dataURL: %s\n", dataURL)
+			if dataURL == "" {
+				working = false
+				continue
+			}
+		}
+	}()
+
+	return resultsChan, nil
+}
+
+func (rt *RottenTomatoesBackend) parseUpcomingMoviesResponse(blob []byte) (*UpcomingMoviesResultPage, error) {
+	umpage := new(UpcomingMoviesResultPage)
+	if err := json.Unmarshal(blob, umpage); err != nil {
+		return nil, err
+	}
+
+	return umpage, nil
+}
@@ -0,0 +1,328 @@
+package fandango
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"sync"
+)
+
+// tmdbBaseURL is TMDb's API v3 root. See https://developers.themoviedb.org/3.
+const tmdbBaseURL = "https://api.themoviedb.org"
+
+// TMDbBackend talks to The Movie Database (TMDb) API v3. It is the
+// default backend, since Rotten Tomatoes' public API was discontinued.
+type TMDbBackend struct {
+	mu           sync.Mutex
+	imageBaseURL string
+	imageSizes   tmdbImageSizes // lazily fetched from /3/configuration
+}
+
+func (*TMDbBackend) Name() string { return "tmdb" }
+
+type tmdbImageSizes struct {
+	Thumbnail string
+	Profile   string
+	Original  string
+}
+
+type tmdbConfiguration struct {
+	Images struct {
+		BaseURL     string   `json:"base_url"`
+		PosterSizes []string `json:"poster_sizes"`
+	} `json:"images"`
+}
+
+// configuration fetches and caches TMDb's image base URL and poster sizes,
+// used to build Poster entries at multiple sizes from a poster_path.
+func (t *TMDbBackend) configuration(ctx context.Context, c *Client) (string, tmdbImageSizes, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.imageBaseURL != "" {
+		return t.imageBaseURL, t.imageSizes, nil
+	}
+
+	fullURL := fmt.Sprintf("%s/3/configuration?api_key=%s", tmdbBaseURL, c.apiKey)
+	blob, err := c.cachedFetchContext(ctx, fullURL, false)
+	if err != nil {
+		return "", tmdbImageSizes{}, err
+	}
+
+	var cfg tmdbConfiguration
+	if err := json.Unmarshal(blob, &cfg); err != nil {
+		return "", tmdbImageSizes{}, err
+	}
+
+	t.imageBaseURL = cfg.Images.BaseURL
+	t.imageSizes = tmdbImageSizes{
+		Thumbnail: pickPosterSize(cfg.Images.PosterSizes, 0),
+		Profile:   pickPosterSize(cfg.Images.PosterSizes, len(cfg.Images.PosterSizes)/2),
+		Original:  "original",
+	}
+
+	return t.imageBaseURL, t.imageSizes, nil
+}
+
+func pickPosterSize(sizes []string, i int) string {
+	if len(sizes) == 0 {
+		return "w185"
+	}
+	if i >= len(sizes) {
+		i = len(sizes) - 1
+	}
+	return sizes[i]
+}
+
+type tmdbMovie struct {
+	ID          int     `json:"id"`
+	Title       string  `json:"title"`
+	Overview    string  `json:"overview"`
+	PosterPath  string  `json:"poster_path"`
+	ReleaseDate string  `json:"release_date"`
+	VoteAverage float32 `json:"vote_average"`
+}
+
+type tmdbMoviesPage struct {
+	Page         int          `json:"page"`
+	Results      []*tmdbMovie `json:"results"`
+	TotalPages   int          `json:"total_pages"`
+	TotalResults int          `json:"total_results"`
+}
+
+// releaseTypeNames maps TMDb's release_dates "type" enum to the keys
+// fandango stores Movie.ReleaseDates under.
+var releaseTypeNames = map[int]string{
+	1: "premiere",
+	2: "theatrical_limited",
+	3: "theatrical",
+	4: "digital",
+	5: "physical",
+	6: "tv",
+}
+
+type tmdbReleaseDatesResponse struct {
+	Results []struct {
+		ISO31661     string `json:"iso_3166_1"`
+		ReleaseDates []struct {
+			Certification string `json:"certification"`
+			ReleaseDate   string `json:"release_date"`
+			Type          int    `json:"type"`
+		} `json:"release_dates"`
+	} `json:"results"`
+}
+
+// releaseDates fetches /3/movie/{id}/release_dates and returns the
+// release-type->date map plus the US MPAA certification, if any.
+func (t *TMDbBackend) releaseDates(ctx context.Context, c *Client, movieID int, forceRefresh bool) (map[string]string, string, error) {
+	fullURL := fmt.Sprintf("%s/3/movie/%d/release_dates?api_key=%s", tmdbBaseURL, movieID, c.apiKey)
+	blob, err := c.cachedFetchContext(ctx, fullURL, forceRefresh)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var parsed tmdbReleaseDatesResponse
+	if err := json.Unmarshal(blob, &parsed); err != nil {
+		return nil, "", err
+	}
+
+	dates := make(map[string]string)
+	mpaaRating := ""
+	for _, country := range parsed.Results {
+		for _, rd := range country.ReleaseDates {
+			if name, ok := releaseTypeNames[rd.Type]; ok {
+				dates[name] = rd.ReleaseDate
+			}
+			if country.ISO31661 == "US" && rd.Certification != "" {
+				mpaaRating = rd.Certification
+			}
+		}
+	}
+
+	return dates, mpaaRating, nil
+}
+
+// movieFromResult builds a Movie from a list/search hit. Fetching
+// release_dates costs one extra rate-limited request per movie, so it's
+// only done when includeReleaseDates is set (MovieByIDContext always
+// sets it; list and search pagination only do when the caller opts in
+// via UpcomingMovieSearch.IncludeReleaseDates / MovieSearch.IncludeReleaseDates,
+// since paying that cost for every movie on every page makes the
+// default rate limit impractically slow).
+func (t *TMDbBackend) movieFromResult(ctx context.Context, c *Client, tm *tmdbMovie, imgBaseURL string, sizes tmdbImageSizes, forceRefresh, includeReleaseDates bool) *Movie {
+	m := &Movie{
+		Title:    tm.Title,
+		Synopsis: tm.Overview,
+		Ratings:  Rating{"tmdb_vote_average": tm.VoteAverage},
+		Links:    LinksMap{"self": fmt.Sprintf("%s/3/movie/%d", tmdbBaseURL, tm.ID)},
+	}
+
+	if len(tm.ReleaseDate) >= 4 {
+		if year, err := strconv.Atoi(tm.ReleaseDate[:4]); err == nil {
+			m.Year = year
+		}
+	}
+
+	if tm.PosterPath != "" {
+		m.Posters = Poster{
+			SzThumbnail: imgBaseURL + sizes.Thumbnail + tm.PosterPath,
+			SzProfile:   imgBaseURL + sizes.Profile + tm.PosterPath,
+			SzOriginal:  imgBaseURL + sizes.Original + tm.PosterPath,
+		}
+	}
+
+	if includeReleaseDates {
+		if dates, mpaaRating, err := t.releaseDates(ctx, c, tm.ID, forceRefresh); err == nil {
+			m.ReleaseDates = dates
+			m.MPAARating = mpaaRating
+		}
+	}
+
+	return m
+}
+
+func (t *TMDbBackend) UpcomingMovies(c *Client, query *UpcomingMovieSearch) (<-chan UpcomingMoviesResult, error) {
+	return t.UpcomingMoviesContext(context.Background(), c, query)
+}
+
+func (t *TMDbBackend) UpcomingMoviesContext(ctx context.Context, c *Client, query *UpcomingMovieSearch) (<-chan UpcomingMoviesResult, error) {
+	return t.list(ctx, c, query, "upcoming")
+}
+
+// NowPlaying streams pages of movies currently in theaters.
+func (t *TMDbBackend) NowPlaying(c *Client, query *UpcomingMovieSearch) (<-chan UpcomingMoviesResult, error) {
+	return t.NowPlayingContext(context.Background(), c, query)
+}
+
+// NowPlayingContext is like NowPlaying but cancels in-flight requests as
+// soon as ctx is done.
+func (t *TMDbBackend) NowPlayingContext(ctx context.Context, c *Client, query *UpcomingMovieSearch) (<-chan UpcomingMoviesResult, error) {
+	return t.list(ctx, c, query, "now_playing")
+}
+
+// Popular streams pages of the currently most popular movies.
+func (t *TMDbBackend) Popular(c *Client, query *UpcomingMovieSearch) (<-chan UpcomingMoviesResult, error) {
+	return t.PopularContext(context.Background(), c, query)
+}
+
+// PopularContext is like Popular but cancels in-flight requests as soon
+// as ctx is done.
+func (t *TMDbBackend) PopularContext(ctx context.Context, c *Client, query *UpcomingMovieSearch) (<-chan UpcomingMoviesResult, error) {
+	return t.list(ctx, c, query, "popular")
+}
+
+// TopRated streams pages of top rated movies.
+func (t *TMDbBackend) TopRated(c *Client, query *UpcomingMovieSearch) (<-chan UpcomingMoviesResult, error) {
+	return t.TopRatedContext(context.Background(), c, query)
+}
+
+// TopRatedContext is like TopRated but cancels in-flight requests as soon
+// as ctx is done.
+func (t *TMDbBackend) TopRatedContext(ctx context.Context, c *Client, query *UpcomingMovieSearch) (<-chan UpcomingMoviesResult, error) {
+	return t.list(ctx, c, query, "top_rated")
+}
+
+func (t *TMDbBackend) makeListURL(c *Client, query *UpcomingMovieSearch, list string, page int) string {
+	values := url.Values{
+		"api_key": []string{c.apiKey},
+	}
+	values.Set("page", fmt.Sprintf("%d", page))
+	if query != nil && query.Country != "" {
+		values.Set("region", query.Country)
+	}
+
+	return fmt.Sprintf("%s/3/movie/%s?%s", tmdbBaseURL, list, values.Encode())
+}
+
+func (t *TMDbBackend) list(ctx context.Context, c *Client, query *UpcomingMovieSearch, list string) (<-chan UpcomingMoviesResult, error) {
+	imgBaseURL, sizes, err := t.configuration(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	page := 1
+	if query != nil && query.MaxPage > 0 {
+		page = query.MaxPage
+	}
+
+	var cancel <-chan struct{}
+	forceRefresh := false
+	includeReleaseDates := false
+	if query != nil {
+		cancel = query.Cancel
+		forceRefresh = query.ForceRefresh
+		includeReleaseDates = query.IncludeReleaseDates
+	}
+
+	resultsChan := make(chan UpcomingMoviesResult)
+	go func() {
+		defer close(resultsChan)
+
+		// Pacing is governed by c.RateLimiter() inside cachedFetchContext,
+		// not a fixed ticker here, so SetRateLimit actually controls how
+		// fast pagination proceeds.
+		working := true
+		for working {
+			select {
+			case <-ctx.Done():
+				select {
+				case resultsChan <- UpcomingMoviesResult{Err: ctx.Err()}:
+				case <-ctx.Done():
+				}
+				return
+			case _, _ = <-cancel:
+				working = false
+				continue
+			default:
+			}
+
+			blob, err := c.cachedFetchContext(ctx, t.makeListURL(c, query, list, page), forceRefresh)
+			if err != nil {
+				select {
+				case resultsChan <- UpcomingMoviesResult{Err: err}:
+				case <-ctx.Done():
+				}
+				return
+			}
+
+			tmPage, err := parseTMDbMoviesPage(blob)
+			if err != nil {
+				select {
+				case resultsChan <- UpcomingMoviesResult{Err: err}:
+				case <-ctx.Done():
+				}
+				return
+			}
+
+			umPage := &UpcomingMoviesResultPage{Total: uint(tmPage.TotalResults)}
+			for _, tm := range tmPage.Results {
+				umPage.Movies = append(umPage.Movies, t.movieFromResult(ctx, c, tm, imgBaseURL, sizes, forceRefresh, includeReleaseDates))
+			}
+
+			select {
+			case resultsChan <- UpcomingMoviesResult{Page: umPage}:
+			case <-ctx.Done():
+				return
+			}
+
+			if page >= tmPage.TotalPages {
+				working = false
+				continue
+			}
+			page++
+		}
+	}()
+
+	return resultsChan, nil
+}
+
+func parseTMDbMoviesPage(blob []byte) (*tmdbMoviesPage, error) {
+	tmPage := new(tmdbMoviesPage)
+	if err := json.Unmarshal(blob, tmPage); err != nil {
+		return nil, err
+	}
+
+	return tmPage, nil
+}
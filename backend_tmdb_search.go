@@ -0,0 +1,601 @@
+package fandango
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+type tmdbSearchMovieResponse struct {
+	Page         int          `json:"page"`
+	Results      []*tmdbMovie `json:"results"`
+	TotalPages   int          `json:"total_pages"`
+	TotalResults int          `json:"total_results"`
+}
+
+func (t *TMDbBackend) SearchMovies(c *Client, query *MovieSearch) (<-chan MovieSearchResult, error) {
+	return t.SearchMoviesContext(context.Background(), c, query)
+}
+
+func (t *TMDbBackend) SearchMoviesContext(ctx context.Context, c *Client, query *MovieSearch) (<-chan MovieSearchResult, error) {
+	imgBaseURL, sizes, err := t.configuration(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	page := 1
+	var cancel <-chan struct{}
+	forceRefresh := false
+	includeReleaseDates := false
+	if query != nil {
+		if query.MaxPage > 0 {
+			page = query.MaxPage
+		}
+		cancel = query.Cancel
+		forceRefresh = query.ForceRefresh
+		includeReleaseDates = query.IncludeReleaseDates
+	}
+
+	resultsChan := make(chan MovieSearchResult)
+	go func() {
+		defer close(resultsChan)
+
+		// Pacing is governed by c.RateLimiter() inside cachedFetchContext,
+		// not a fixed ticker here, so SetRateLimit actually controls how
+		// fast pagination proceeds.
+		working := true
+		for working {
+			select {
+			case <-ctx.Done():
+				select {
+				case resultsChan <- MovieSearchResult{Err: ctx.Err()}:
+				case <-ctx.Done():
+				}
+				return
+			case _, _ = <-cancel:
+				working = false
+				continue
+			default:
+			}
+
+			blob, err := c.cachedFetchContext(ctx, t.makeSearchMovieURL(c, query, page), forceRefresh)
+			if err != nil {
+				select {
+				case resultsChan <- MovieSearchResult{Err: err}:
+				case <-ctx.Done():
+				}
+				return
+			}
+
+			var parsed tmdbSearchMovieResponse
+			if err := json.Unmarshal(blob, &parsed); err != nil {
+				select {
+				case resultsChan <- MovieSearchResult{Err: err}:
+				case <-ctx.Done():
+				}
+				return
+			}
+
+			pageResult := &MovieSearchResultPage{Total: uint(parsed.TotalResults)}
+			for _, tm := range parsed.Results {
+				pageResult.Movies = append(pageResult.Movies, t.movieFromResult(ctx, c, tm, imgBaseURL, sizes, forceRefresh, includeReleaseDates))
+			}
+
+			select {
+			case resultsChan <- MovieSearchResult{Page: pageResult}:
+			case <-ctx.Done():
+				return
+			}
+
+			if page >= parsed.TotalPages {
+				working = false
+				continue
+			}
+			page++
+		}
+	}()
+
+	return resultsChan, nil
+}
+
+func (t *TMDbBackend) makeSearchMovieURL(c *Client, query *MovieSearch, page int) string {
+	values := url.Values{"api_key": []string{c.apiKey}}
+	values.Set("page", fmt.Sprintf("%d", page))
+	if query != nil {
+		if query.Query != "" {
+			values.Set("query", query.Query)
+		}
+		if query.Year > 0 {
+			values.Set("year", fmt.Sprintf("%d", query.Year))
+		}
+		if query.Country != "" {
+			values.Set("region", query.Country)
+		}
+	}
+
+	return fmt.Sprintf("%s/3/search/movie?%s", tmdbBaseURL, values.Encode())
+}
+
+type tmdbPersonResult struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	ProfilePath string `json:"profile_path"`
+}
+
+type tmdbSearchPersonResponse struct {
+	Page         int                 `json:"page"`
+	Results      []*tmdbPersonResult `json:"results"`
+	TotalPages   int                 `json:"total_pages"`
+	TotalResults int                 `json:"total_results"`
+}
+
+func (t *TMDbBackend) SearchPeople(c *Client, query *PersonSearch) (<-chan PersonSearchResult, error) {
+	return t.SearchPeopleContext(context.Background(), c, query)
+}
+
+func (t *TMDbBackend) SearchPeopleContext(ctx context.Context, c *Client, query *PersonSearch) (<-chan PersonSearchResult, error) {
+	imgBaseURL, sizes, err := t.configuration(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	page := 1
+	var cancel <-chan struct{}
+	forceRefresh := false
+	if query != nil {
+		if query.MaxPage > 0 {
+			page = query.MaxPage
+		}
+		cancel = query.Cancel
+		forceRefresh = query.ForceRefresh
+	}
+
+	resultsChan := make(chan PersonSearchResult)
+	go func() {
+		defer close(resultsChan)
+
+		// Pacing is governed by c.RateLimiter() inside cachedFetchContext,
+		// not a fixed ticker here, so SetRateLimit actually controls how
+		// fast pagination proceeds.
+		working := true
+		for working {
+			select {
+			case <-ctx.Done():
+				select {
+				case resultsChan <- PersonSearchResult{Err: ctx.Err()}:
+				case <-ctx.Done():
+				}
+				return
+			case _, _ = <-cancel:
+				working = false
+				continue
+			default:
+			}
+
+			blob, err := c.cachedFetchContext(ctx, t.makeSearchPersonURL(c, query, page), forceRefresh)
+			if err != nil {
+				select {
+				case resultsChan <- PersonSearchResult{Err: err}:
+				case <-ctx.Done():
+				}
+				return
+			}
+
+			var parsed tmdbSearchPersonResponse
+			if err := json.Unmarshal(blob, &parsed); err != nil {
+				select {
+				case resultsChan <- PersonSearchResult{Err: err}:
+				case <-ctx.Done():
+				}
+				return
+			}
+
+			pageResult := &PersonSearchResultPage{Total: uint(parsed.TotalResults)}
+			for _, person := range parsed.Results {
+				pageResult.People = append(pageResult.People, t.personFromResult(person, imgBaseURL, sizes))
+			}
+
+			select {
+			case resultsChan <- PersonSearchResult{Page: pageResult}:
+			case <-ctx.Done():
+				return
+			}
+
+			if page >= parsed.TotalPages {
+				working = false
+				continue
+			}
+			page++
+		}
+	}()
+
+	return resultsChan, nil
+}
+
+func (t *TMDbBackend) makeSearchPersonURL(c *Client, query *PersonSearch, page int) string {
+	values := url.Values{"api_key": []string{c.apiKey}}
+	values.Set("page", fmt.Sprintf("%d", page))
+	if query != nil && query.Query != "" {
+		values.Set("query", query.Query)
+	}
+
+	return fmt.Sprintf("%s/3/search/person?%s", tmdbBaseURL, values.Encode())
+}
+
+func (t *TMDbBackend) personFromResult(person *tmdbPersonResult, imgBaseURL string, sizes tmdbImageSizes) *Person {
+	p := &Person{
+		ID:   strconv.Itoa(person.ID),
+		Name: person.Name,
+	}
+
+	if person.ProfilePath != "" {
+		p.Photos = Poster{
+			SzThumbnail: imgBaseURL + sizes.Thumbnail + person.ProfilePath,
+			SzProfile:   imgBaseURL + sizes.Profile + person.ProfilePath,
+			SzOriginal:  imgBaseURL + sizes.Original + person.ProfilePath,
+		}
+	}
+
+	return p
+}
+
+type tmdbNamedEntity struct {
+	Name string `json:"name"`
+}
+
+type tmdbMovieDetails struct {
+	ID          int     `json:"id"`
+	Title       string  `json:"title"`
+	Overview    string  `json:"overview"`
+	PosterPath  string  `json:"poster_path"`
+	ReleaseDate string  `json:"release_date"`
+	Runtime     float32 `json:"runtime"`
+	VoteAverage float32 `json:"vote_average"`
+	Budget      int64   `json:"budget"`
+	Revenue     int64   `json:"revenue"`
+
+	Genres              []tmdbNamedEntity `json:"genres"`
+	ProductionCompanies []tmdbNamedEntity `json:"production_companies"`
+	Keywords            struct {
+		Keywords []tmdbNamedEntity `json:"keywords"`
+	} `json:"keywords"`
+	Videos struct {
+		Results []struct {
+			Name string `json:"name"`
+			Key  string `json:"key"`
+			Site string `json:"site"`
+		} `json:"results"`
+	} `json:"videos"`
+	Similar struct {
+		Results []struct {
+			ID    int    `json:"id"`
+			Title string `json:"title"`
+		} `json:"results"`
+	} `json:"similar"`
+	Recommendations struct {
+		Results []struct {
+			ID    int    `json:"id"`
+			Title string `json:"title"`
+		} `json:"results"`
+	} `json:"recommendations"`
+}
+
+// MovieByIDContext fetches a single movie's full details, including
+// budget/revenue, genres, trailers, and similar/recommended titles, in
+// one request via TMDb's append_to_response. Its release dates and MPAA
+// rating take a second request, to /3/movie/{id}/release_dates.
+func (t *TMDbBackend) MovieByIDContext(ctx context.Context, c *Client, id string) (*MovieDetails, error) {
+	imgBaseURL, sizes, err := t.configuration(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	values := url.Values{
+		"api_key":            []string{c.apiKey},
+		"append_to_response": []string{"videos,similar,recommendations,keywords"},
+	}
+	fullURL := fmt.Sprintf("%s/3/movie/%s?%s", tmdbBaseURL, id, values.Encode())
+
+	blob, err := c.cachedFetchContext(ctx, fullURL, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var details tmdbMovieDetails
+	if err := json.Unmarshal(blob, &details); err != nil {
+		return nil, err
+	}
+
+	tm := &tmdbMovie{
+		ID:          details.ID,
+		Title:       details.Title,
+		Overview:    details.Overview,
+		PosterPath:  details.PosterPath,
+		ReleaseDate: details.ReleaseDate,
+		VoteAverage: details.VoteAverage,
+	}
+
+	md := &MovieDetails{
+		Movie:   *t.movieFromResult(ctx, c, tm, imgBaseURL, sizes, false, true),
+		Budget:  details.Budget,
+		Revenue: details.Revenue,
+	}
+	md.RuntimeMinutes = details.Runtime
+
+	for _, genre := range details.Genres {
+		md.Genres = append(md.Genres, genre.Name)
+	}
+	for _, company := range details.ProductionCompanies {
+		md.ProductionCompanies = append(md.ProductionCompanies, company.Name)
+	}
+	for _, keyword := range details.Keywords.Keywords {
+		md.Keywords = append(md.Keywords, keyword.Name)
+	}
+
+	md.Videos = make(LinksMap)
+	for _, v := range details.Videos.Results {
+		if v.Site == "YouTube" {
+			md.Videos[v.Name] = "https://www.youtube.com/watch?v=" + v.Key
+		}
+	}
+
+	md.Similar = make(LinksMap)
+	for _, s := range details.Similar.Results {
+		md.Similar[s.Title] = fmt.Sprintf("%s/3/movie/%d", tmdbBaseURL, s.ID)
+	}
+
+	md.Recommendations = make(LinksMap)
+	for _, r := range details.Recommendations.Results {
+		md.Recommendations[r.Title] = fmt.Sprintf("%s/3/movie/%d", tmdbBaseURL, r.ID)
+	}
+
+	return md, nil
+}
+
+type tmdbPersonDetails struct {
+	ID           int    `json:"id"`
+	Name         string `json:"name"`
+	Biography    string `json:"biography"`
+	Birthday     string `json:"birthday"`
+	ProfilePath  string `json:"profile_path"`
+	MovieCredits struct {
+		Cast []struct {
+			ID    int    `json:"id"`
+			Title string `json:"title"`
+		} `json:"cast"`
+	} `json:"movie_credits"`
+}
+
+// PersonByIDContext fetches a single person's details, including their
+// filmography, in one request via TMDb's append_to_response.
+func (t *TMDbBackend) PersonByIDContext(ctx context.Context, c *Client, id string) (*Person, error) {
+	imgBaseURL, sizes, err := t.configuration(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	values := url.Values{
+		"api_key":            []string{c.apiKey},
+		"append_to_response": []string{"movie_credits"},
+	}
+	fullURL := fmt.Sprintf("%s/3/person/%s?%s", tmdbBaseURL, id, values.Encode())
+
+	blob, err := c.cachedFetchContext(ctx, fullURL, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var details tmdbPersonDetails
+	if err := json.Unmarshal(blob, &details); err != nil {
+		return nil, err
+	}
+
+	p := &Person{
+		ID:        strconv.Itoa(details.ID),
+		Name:      details.Name,
+		Biography: details.Biography,
+		Birthday:  details.Birthday,
+	}
+
+	if details.ProfilePath != "" {
+		p.Photos = Poster{
+			SzThumbnail: imgBaseURL + sizes.Thumbnail + details.ProfilePath,
+			SzProfile:   imgBaseURL + sizes.Profile + details.ProfilePath,
+			SzOriginal:  imgBaseURL + sizes.Original + details.ProfilePath,
+		}
+	}
+
+	p.Filmography = make(LinksMap)
+	for _, credit := range details.MovieCredits.Cast {
+		p.Filmography[credit.Title] = fmt.Sprintf("%s/3/movie/%d", tmdbBaseURL, credit.ID)
+	}
+
+	return p, nil
+}
+
+type tmdbTVResult struct {
+	ID           int    `json:"id"`
+	Name         string `json:"name"`
+	Overview     string `json:"overview"`
+	PosterPath   string `json:"poster_path"`
+	FirstAirDate string `json:"first_air_date"`
+}
+
+type tmdbSearchTVResponse struct {
+	Page         int             `json:"page"`
+	Results      []*tmdbTVResult `json:"results"`
+	TotalPages   int             `json:"total_pages"`
+	TotalResults int             `json:"total_results"`
+}
+
+type tmdbTVDetails struct {
+	Seasons []struct {
+		SeasonNumber int    `json:"season_number"`
+		Name         string `json:"name"`
+		AirDate      string `json:"air_date"`
+	} `json:"seasons"`
+}
+
+type tmdbSeasonDetails struct {
+	Episodes []struct {
+		EpisodeNumber int     `json:"episode_number"`
+		Name          string  `json:"name"`
+		AirDate       string  `json:"air_date"`
+		Overview      string  `json:"overview"`
+		Runtime       float32 `json:"runtime"`
+	} `json:"episodes"`
+}
+
+func (t *TMDbBackend) TVSeries(c *Client, query *TVSearch) (<-chan TVSeriesResult, error) {
+	return t.TVSeriesContext(context.Background(), c, query)
+}
+
+func (t *TMDbBackend) TVSeriesContext(ctx context.Context, c *Client, query *TVSearch) (<-chan TVSeriesResult, error) {
+	imgBaseURL, sizes, err := t.configuration(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	page := 1
+	var cancel <-chan struct{}
+	forceRefresh := false
+	if query != nil {
+		if query.MaxPage > 0 {
+			page = query.MaxPage
+		}
+		cancel = query.Cancel
+		forceRefresh = query.ForceRefresh
+	}
+
+	resultsChan := make(chan TVSeriesResult)
+	go func() {
+		defer close(resultsChan)
+
+		// Pacing is governed by c.RateLimiter() inside cachedFetchContext,
+		// not a fixed ticker here, so SetRateLimit actually controls how
+		// fast pagination proceeds.
+		working := true
+		for working {
+			select {
+			case <-ctx.Done():
+				select {
+				case resultsChan <- TVSeriesResult{Err: ctx.Err()}:
+				case <-ctx.Done():
+				}
+				return
+			case _, _ = <-cancel:
+				working = false
+				continue
+			default:
+			}
+
+			blob, err := c.cachedFetchContext(ctx, t.makeSearchTVURL(c, query, page), forceRefresh)
+			if err != nil {
+				select {
+				case resultsChan <- TVSeriesResult{Err: err}:
+				case <-ctx.Done():
+				}
+				return
+			}
+
+			var parsed tmdbSearchTVResponse
+			if err := json.Unmarshal(blob, &parsed); err != nil {
+				select {
+				case resultsChan <- TVSeriesResult{Err: err}:
+				case <-ctx.Done():
+				}
+				return
+			}
+
+			pageResult := &TVSeriesResultPage{Total: uint(parsed.TotalResults)}
+			for _, tv := range parsed.Results {
+				pageResult.Series = append(pageResult.Series, t.tvSeriesFromResult(ctx, c, tv, imgBaseURL, sizes, forceRefresh))
+			}
+
+			select {
+			case resultsChan <- TVSeriesResult{Page: pageResult}:
+			case <-ctx.Done():
+				return
+			}
+
+			if page >= parsed.TotalPages {
+				working = false
+				continue
+			}
+			page++
+		}
+	}()
+
+	return resultsChan, nil
+}
+
+func (t *TMDbBackend) makeSearchTVURL(c *Client, query *TVSearch, page int) string {
+	values := url.Values{"api_key": []string{c.apiKey}}
+	values.Set("page", fmt.Sprintf("%d", page))
+	if query != nil && query.Query != "" {
+		values.Set("query", query.Query)
+	}
+
+	return fmt.Sprintf("%s/3/search/tv?%s", tmdbBaseURL, values.Encode())
+}
+
+// tvSeriesFromResult enriches a search hit with its full season/episode
+// listing, fetched via /3/tv/{id} and /3/tv/{id}/season/{n}.
+func (t *TMDbBackend) tvSeriesFromResult(ctx context.Context, c *Client, tv *tmdbTVResult, imgBaseURL string, sizes tmdbImageSizes, forceRefresh bool) *TVSeries {
+	series := &TVSeries{
+		ID:           strconv.Itoa(tv.ID),
+		Name:         tv.Name,
+		Synopsis:     tv.Overview,
+		FirstAirDate: tv.FirstAirDate,
+	}
+
+	if tv.PosterPath != "" {
+		series.Posters = Poster{
+			SzThumbnail: imgBaseURL + sizes.Thumbnail + tv.PosterPath,
+			SzProfile:   imgBaseURL + sizes.Profile + tv.PosterPath,
+			SzOriginal:  imgBaseURL + sizes.Original + tv.PosterPath,
+		}
+	}
+
+	fullURL := fmt.Sprintf("%s/3/tv/%d?api_key=%s", tmdbBaseURL, tv.ID, c.apiKey)
+	blob, err := c.cachedFetchContext(ctx, fullURL, forceRefresh)
+	if err != nil {
+		return series
+	}
+
+	var details tmdbTVDetails
+	if err := json.Unmarshal(blob, &details); err != nil {
+		return series
+	}
+
+	for _, s := range details.Seasons {
+		season := &Season{
+			SeasonNumber: s.SeasonNumber,
+			Name:         s.Name,
+			AirDate:      s.AirDate,
+		}
+
+		seasonURL := fmt.Sprintf("%s/3/tv/%d/season/%d?api_key=%s", tmdbBaseURL, tv.ID, s.SeasonNumber, c.apiKey)
+		seasonBlob, err := c.cachedFetchContext(ctx, seasonURL, forceRefresh)
+		if err == nil {
+			var seasonDetails tmdbSeasonDetails
+			if json.Unmarshal(seasonBlob, &seasonDetails) == nil {
+				for _, e := range seasonDetails.Episodes {
+					season.Episodes = append(season.Episodes, &Episode{
+						EpisodeNumber:  e.EpisodeNumber,
+						Name:           e.Name,
+						AirDate:        e.AirDate,
+						Synopsis:       e.Overview,
+						RuntimeMinutes: e.Runtime,
+					})
+				}
+			}
+		}
+
+		series.Seasons = append(series.Seasons, season)
+	}
+
+	return series
+}
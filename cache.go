@@ -0,0 +1,84 @@
+package fandango
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Cache lets Client reuse movie-list API responses across calls (and,
+// for FileCache, across process restarts) instead of reissuing an HTTP
+// request on every call. Entries are keyed by the fully-formed request
+// URL with API key query parameters stripped out, so a cache can be
+// shared safely across callers using different keys.
+type Cache interface {
+	// Get returns the cached body for key, whether it was found, and the
+	// time it expires at. The zero Time means "does not expire". A found
+	// entry may already be past its expiry; cachedFetch revalidates it.
+	Get(key string) ([]byte, bool, time.Time)
+
+	// Set stores body under key, expiring it after ttl. A ttl <= 0 means
+	// the entry does not expire on its own (used for ETags).
+	Set(key string, body []byte, ttl time.Duration)
+}
+
+// SetCache configures the Cache that Client's list methods read through.
+// A nil cache (the default) disables caching entirely.
+func (c *Client) SetCache(cache Cache) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.cache = cache
+}
+
+// Cache returns the Client's configured Cache, or nil if none is set.
+func (c *Client) Cache() Cache {
+	c.RLock()
+	defer c.RUnlock()
+
+	return c.cache
+}
+
+const defaultCacheTTL = 5 * time.Minute
+
+// cacheKey derives a cache key from a fully-formed request URL by
+// stripping its API key query parameter.
+func cacheKey(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+
+	values := u.Query()
+	values.Del("apikey")
+	values.Del("api_key")
+	u.RawQuery = values.Encode()
+	return u.String()
+}
+
+// cacheTTL derives how long a response may be cached for from its
+// Cache-Control header, falling back to defaultCacheTTL when the header
+// is absent or unparseable.
+func cacheTTL(header http.Header) time.Duration {
+	cc := header.Get("Cache-Control")
+	if cc == "" {
+		return defaultCacheTTL
+	}
+
+	for _, directive := range strings.Split(cc, ",") {
+		directive = strings.TrimSpace(directive)
+		switch {
+		case directive == "no-store" || directive == "no-cache":
+			return 0
+		case strings.HasPrefix(directive, "max-age="):
+			secs, err := strconv.Atoi(strings.TrimPrefix(directive, "max-age="))
+			if err == nil && secs >= 0 {
+				return time.Duration(secs) * time.Second
+			}
+		}
+	}
+
+	return defaultCacheTTL
+}
@@ -0,0 +1,80 @@
+package fandango
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// FileCache is a Cache implementation backed by a directory on disk, so
+// cached responses survive process restarts and can be shared between
+// processes on the same machine.
+type FileCache struct {
+	mu  sync.Mutex
+	dir string
+}
+
+// NewFileCache creates a FileCache rooted at dir, creating dir if it
+// doesn't already exist.
+func NewFileCache(dir string) (*FileCache, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	return &FileCache{dir: dir}, nil
+}
+
+type fileCacheMeta struct {
+	Expires time.Time `json:"expires"`
+}
+
+func (f *FileCache) pathsFor(key string) (body, meta string) {
+	sum := sha1.Sum([]byte(key))
+	name := hex.EncodeToString(sum[:])
+	return filepath.Join(f.dir, name+".body"), filepath.Join(f.dir, name+".meta")
+}
+
+func (f *FileCache) Get(key string) ([]byte, bool, time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	bodyPath, metaPath := f.pathsFor(key)
+	body, err := ioutil.ReadFile(bodyPath)
+	if err != nil {
+		return nil, false, time.Time{}
+	}
+
+	var meta fileCacheMeta
+	if metaBlob, err := ioutil.ReadFile(metaPath); err == nil {
+		_ = json.Unmarshal(metaBlob, &meta)
+	}
+
+	return body, true, meta.Expires
+}
+
+func (f *FileCache) Set(key string, body []byte, ttl time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	bodyPath, metaPath := f.pathsFor(key)
+	if err := ioutil.WriteFile(bodyPath, body, 0644); err != nil {
+		return
+	}
+
+	var expires time.Time
+	if ttl > 0 {
+		expires = time.Now().Add(ttl)
+	}
+
+	metaBlob, err := json.Marshal(fileCacheMeta{Expires: expires})
+	if err != nil {
+		return
+	}
+
+	_ = ioutil.WriteFile(metaPath, metaBlob, 0644)
+}
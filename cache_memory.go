@@ -0,0 +1,79 @@
+package fandango
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// MemoryCache is an in-memory, LRU-evicted Cache implementation. It does
+// not survive process restarts; use FileCache for that.
+type MemoryCache struct {
+	mu       sync.Mutex
+	capacity int
+	ll       *list.List
+	items    map[string]*list.Element
+}
+
+type memoryCacheEntry struct {
+	key     string
+	body    []byte
+	expires time.Time
+}
+
+// NewMemoryCache creates a MemoryCache holding at most capacity entries,
+// evicting the least recently used one once full. A non-positive
+// capacity defaults to 128.
+func NewMemoryCache(capacity int) *MemoryCache {
+	if capacity <= 0 {
+		capacity = 128
+	}
+
+	return &MemoryCache{
+		capacity: capacity,
+		ll:       list.New(),
+		items:    make(map[string]*list.Element),
+	}
+}
+
+func (m *MemoryCache) Get(key string) ([]byte, bool, time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	el, ok := m.items[key]
+	if !ok {
+		return nil, false, time.Time{}
+	}
+
+	m.ll.MoveToFront(el)
+	entry := el.Value.(*memoryCacheEntry)
+	return entry.body, true, entry.expires
+}
+
+func (m *MemoryCache) Set(key string, body []byte, ttl time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var expires time.Time
+	if ttl > 0 {
+		expires = time.Now().Add(ttl)
+	}
+
+	if el, ok := m.items[key]; ok {
+		m.ll.MoveToFront(el)
+		entry := el.Value.(*memoryCacheEntry)
+		entry.body, entry.expires = body, expires
+		return
+	}
+
+	el := m.ll.PushFront(&memoryCacheEntry{key: key, body: body, expires: expires})
+	m.items[key] = el
+
+	if m.ll.Len() > m.capacity {
+		oldest := m.ll.Back()
+		if oldest != nil {
+			m.ll.Remove(oldest)
+			delete(m.items, oldest.Value.(*memoryCacheEntry).key)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package fandango
+
+import "testing"
+
+func TestMemoryCacheGetSet(t *testing.T) {
+	mc := NewMemoryCache(10)
+
+	if _, ok, _ := mc.Get("a"); ok {
+		t.Fatalf("Get on empty cache returned ok")
+	}
+
+	mc.Set("a", []byte("1"), 0)
+	body, ok, _ := mc.Get("a")
+	if !ok || string(body) != "1" {
+		t.Fatalf("Get(%q) = %q, %v, want %q, true", "a", body, ok, "1")
+	}
+}
+
+func TestMemoryCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	mc := NewMemoryCache(2)
+
+	mc.Set("a", []byte("1"), 0)
+	mc.Set("b", []byte("2"), 0)
+
+	// Touch "a" so "b" becomes the least recently used entry.
+	mc.Get("a")
+
+	mc.Set("c", []byte("3"), 0)
+
+	if _, ok, _ := mc.Get("b"); ok {
+		t.Errorf("Get(%q) after eviction returned ok, want evicted", "b")
+	}
+	if _, ok, _ := mc.Get("a"); !ok {
+		t.Errorf("Get(%q) = not found, want still present", "a")
+	}
+	if _, ok, _ := mc.Get("c"); !ok {
+		t.Errorf("Get(%q) = not found, want still present", "c")
+	}
+}
+
+func TestMemoryCacheDefaultsCapacity(t *testing.T) {
+	mc := NewMemoryCache(0)
+	if mc.capacity != 128 {
+		t.Errorf("capacity = %d, want 128", mc.capacity)
+	}
+}
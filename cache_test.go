@@ -0,0 +1,44 @@
+package fandango
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestCacheTTL(t *testing.T) {
+	tests := [...]struct {
+		name         string
+		cacheControl string
+		want         time.Duration
+	}{
+		{name: "absent", cacheControl: "", want: defaultCacheTTL},
+		{name: "no-store", cacheControl: "no-store", want: 0},
+		{name: "no-cache", cacheControl: "no-cache", want: 0},
+		{name: "max-age=0", cacheControl: "max-age=0", want: 0},
+		{name: "max-age=60", cacheControl: "max-age=60", want: 60 * time.Second},
+		{name: "unparseable falls back to default", cacheControl: "max-age=nope", want: defaultCacheTTL},
+		{name: "private, max-age=30", cacheControl: "private, max-age=30", want: 30 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := make(http.Header)
+			if tt.cacheControl != "" {
+				header.Set("Cache-Control", tt.cacheControl)
+			}
+
+			got := cacheTTL(header)
+			if got != tt.want {
+				t.Errorf("cacheTTL(%q) = %v, want %v", tt.cacheControl, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCacheKeyStripsAPIKey(t *testing.T) {
+	got := cacheKey("https://api.themoviedb.org/3/movie/upcoming?api_key=secret&page=1")
+	if got != "https://api.themoviedb.org/3/movie/upcoming?page=1" {
+		t.Errorf("cacheKey = %q, want api_key stripped", got)
+	}
+}
@@ -14,8 +14,10 @@ func Example_clientUpcomingMovies() {
 	}
 
 	query := &fandango.UpcomingMovieSearch{
-		MaxPage:      1,
-		ItemsPerPage: 10,
+		MaxPage: 1,
+		// Opt into the extra per-movie release_dates request; leave this
+		// off in latency-sensitive code.
+		IncludeReleaseDates: true,
 	}
 
 	pagesChan, err := client.UpcomingMovies(query)
@@ -24,7 +26,12 @@ func Example_clientUpcomingMovies() {
 	}
 
 	ithPage := uint64(0)
-	for page := range pagesChan {
+	for result := range pagesChan {
+		if result.Err != nil {
+			log.Fatal(result.Err)
+		}
+
+		page := result.Page
 		fmt.Printf("Page: %d Total #Movies: %d\n", ithPage, page.Total)
 		for i, movie := range page.Movies {
 			fmt.Printf("\t%d %s Year: %d Rating: %s. \n\tSynopsis: %s\n",
@@ -1,22 +1,21 @@
 package fandango
 
 import (
-	"encoding/json"
+	"context"
 	"errors"
-	"fmt"
-	"io/ioutil"
-	"net/http"
-	"net/url"
 	"os"
 	"strings"
 	"sync"
-	"time"
 )
 
 type Client struct {
 	sync.RWMutex
-	version string
-	apiKey  string
+	version     string
+	apiKey      string
+	backend     Backend
+	cache       Cache
+	rateLimiter *RateLimiter
+	retryPolicy *RetryPolicy
 }
 
 func NewDefaultClient(apiKeysToTry ...string) (*Client, error) {
@@ -118,90 +117,60 @@ type UpcomingMoviesResultPage struct {
 	LinkTemplate string   `json:"link_template"`
 }
 
-type UpcomingMovieSearch struct {
-	ItemsPerPage int             `json:"page_limit"`
-	MaxPage      int             `json:"page"`
-	Country      string          `json:"country"`
-	Cancel       <-chan struct{} `json:"-"`
+// UpcomingMoviesResult is sent on the channel returned by UpcomingMovies
+// and the other list methods. Exactly one of Page or Err is set, so
+// callers can distinguish a failed page from the channel simply running
+// dry.
+type UpcomingMoviesResult struct {
+	Page *UpcomingMoviesResultPage
+	Err  error
 }
 
-// http://api.rottentomatoes.com/api/public/v1.0/lists/movies/upcoming.json?apikey=[your_api_key]&page_limit=1
-const baseURL = "http://api.rottentomatoes.com/api/public"
-
-func (c *Client) makeUpcomingMoviesURL(q *UpcomingMovieSearch) (string, error) {
-	values := url.Values{
-		"apikey": []string{c.apiKey},
-	}
-	if q != nil {
-		if q.ItemsPerPage > 0 {
-			values.Set("page_limit", fmt.Sprintf("%d", q.ItemsPerPage))
-		}
-		if q.MaxPage > 0 {
-			values.Set("page", fmt.Sprintf("%d", q.MaxPage))
-		}
-		if q.Country != "" {
-			values.Set("country", q.Country)
-		}
-	}
-
-	fullURL := fmt.Sprintf("%s/v%s/lists/movies/upcoming/json?%s", baseURL, c.APIVersion(), values.Encode())
-	return fullURL, nil
+type UpcomingMovieSearch struct {
+	// ItemsPerPage is only honored by RottenTomatoesBackend. TMDb's
+	// endpoints return a fixed 20 results per page and don't accept a
+	// page size, so it is a no-op under TMDbBackend, the default.
+	ItemsPerPage int    `json:"page_limit"`
+	MaxPage      int    `json:"page"`
+	Country      string `json:"country"`
+
+	// Deprecated: Cancel only takes effect between throttle ticks and
+	// doesn't cancel an in-flight HTTP request. Use UpcomingMoviesContext
+	// (or the Context variant of any other list method) instead.
+	Cancel <-chan struct{} `json:"-"`
+
+	// ForceRefresh bypasses the Client's Cache, if any, forcing a fresh
+	// request upstream and repopulating the cache with the result.
+	ForceRefresh bool `json:"-"`
+
+	// IncludeReleaseDates has TMDbBackend fetch each movie's
+	// release_dates (and MPAA rating) inline, which costs one extra
+	// rate-limited request per movie on every page. It defaults to
+	// false; use Client.MovieByID for that detail on a single movie
+	// without paying the cost for the whole list.
+	IncludeReleaseDates bool `json:"-"`
 }
 
 var errEmptyAPIKey = errors.New("empty api key")
 
-func (c *Client) UpcomingMovies(query *UpcomingMovieSearch) (<-chan *UpcomingMoviesResultPage, error) {
+// UpcomingMovies streams pages of upcoming movies matching query, using
+// whichever Backend is configured on c (see SetBackend). The default
+// backend is TMDbBackend, since Rotten Tomatoes' public API has been
+// discontinued.
+func (c *Client) UpcomingMovies(query *UpcomingMovieSearch) (<-chan UpcomingMoviesResult, error) {
+	return c.UpcomingMoviesContext(context.Background(), query)
+}
+
+// UpcomingMoviesContext is like UpcomingMovies but cancels in-flight
+// requests as soon as ctx is done, instead of only between throttle
+// ticks.
+func (c *Client) UpcomingMoviesContext(ctx context.Context, query *UpcomingMovieSearch) (<-chan UpcomingMoviesResult, error) {
 	apiKey := c.APIKey()
 	if apiKey == "" {
 		return nil, errEmptyAPIKey
 	}
 
-	dataURL, err := c.makeUpcomingMoviesURL(query)
-	// log.Printf("dataURL: %s err: %v\n", dataURL, err)
-	if err != nil {
-		return nil, err
-	}
-
-	pagesChan := make(chan *UpcomingMoviesResultPage)
-	go func() {
-		defer close(pagesChan)
-
-		throttle := time.NewTicker(1e9)
-		working := true
-		for working {
-			select {
-			case _, _ = <-query.Cancel:
-				break
-			case <-throttle.C:
-				res, err := http.Get(dataURL)
-				// log.Printf("res: %#v err: %v\n", res, err)
-				if err != nil {
-					// TODO: handle this error
-					working = false
-					break
-				}
-				page, err := parseUpcomingMoviesResponse(res)
-				// log.Printf("page: %#v err: %v\n", page, err)
-				if err != nil {
-					working = false
-					// TODO: handle this error
-					break
-				}
-
-				pagesChan <- page
-
-				// Set to the next page if we have one.
-				dataURL = page.Links.GetNextURL()
-				// log.Printf("next::dataURL: %s\n", dataURL)
-				if dataURL == "" {
-					working = false
-					break
-				}
-			}
-		}
-	}()
-
-	return pagesChan, nil
+	return c.Backend().UpcomingMoviesContext(ctx, c, query)
 }
 
 func (l *LinksMap) GetNextURL() string {
@@ -213,22 +182,3 @@ func (l *LinksMap) GetNextURL() string {
 }
 
 func statusOK(code int) bool { return code >= 200 && code <= 299 }
-
-func parseUpcomingMoviesResponse(res *http.Response) (*UpcomingMoviesResultPage, error) {
-	defer res.Body.Close()
-
-	if !statusOK(res.StatusCode) {
-		return nil, fmt.Errorf("%s", res.Status)
-	}
-	blob, err := ioutil.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	umpage := new(UpcomingMoviesResultPage)
-	if err := json.Unmarshal(blob, umpage); err != nil {
-		return nil, err
-	}
-
-	return umpage, nil
-}
@@ -0,0 +1,134 @@
+package fandango
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+// cachedFetch is like cachedFetchContext but without cancellation.
+func (c *Client) cachedFetch(dataURL string, forceRefresh bool) ([]byte, error) {
+	return c.cachedFetchContext(context.Background(), dataURL, forceRefresh)
+}
+
+// cachedFetchContext GETs dataURL, reading through c's Cache when one is
+// configured, throttled by c's RateLimiter and retried per c's
+// RetryPolicy on HTTP 429, transient 5xx responses, and network errors.
+// It revalidates stale cache entries with If-None-Match and refreshes
+// the cached TTL from the response's Cache-Control header. ctx cancels
+// the in-flight request and any pending rate-limit wait or retry delay
+// immediately.
+func (c *Client) cachedFetchContext(ctx context.Context, dataURL string, forceRefresh bool) ([]byte, error) {
+	cache := c.Cache()
+	key := cacheKey(dataURL)
+
+	var etag string
+	if cache != nil && !forceRefresh {
+		if body, ok, expires := cache.Get(key); ok {
+			if expires.IsZero() || time.Now().Before(expires) {
+				return body, nil
+			}
+		}
+		if etagBlob, ok, _ := cache.Get(key + "#etag"); ok {
+			etag = string(etagBlob)
+		}
+	}
+
+	policy := c.RetryPolicy()
+	limiter := c.RateLimiter()
+
+	var lastErr error
+	for attempt := 0; ; attempt++ {
+		if err := limiter.WaitContext(ctx); err != nil {
+			return nil, err
+		}
+
+		blob, retryAfter, retryable, err := c.fetchOnce(ctx, dataURL, etag, cache, key)
+		if err == nil {
+			return blob, nil
+		}
+
+		lastErr = err
+		if !retryable || attempt >= policy.MaxRetries {
+			return nil, lastErr
+		}
+
+		if err := sleepContext(ctx, policy.backoff(attempt, retryAfter)); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// fetchOnce issues a single GET, reporting whether the failure (if any)
+// is worth retrying.
+func (c *Client) fetchOnce(ctx context.Context, dataURL, etag string, cache Cache, key string) (blob []byte, retryAfter time.Duration, retryable bool, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, dataURL, nil)
+	if err != nil {
+		return nil, 0, false, err
+	}
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, 0, true, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotModified {
+		body, ok, _ := cache.Get(key)
+		if !ok {
+			return nil, 0, false, fmt.Errorf("%s", res.Status)
+		}
+		if ttl := cacheTTL(res.Header); ttl > 0 {
+			cache.Set(key, body, ttl)
+		}
+		return body, 0, false, nil
+	}
+
+	if isRetryableStatus(res.StatusCode) {
+		return nil, retryAfterFromHeader(res.Header), true, fmt.Errorf("%s", res.Status)
+	}
+
+	if !statusOK(res.StatusCode) {
+		return nil, 0, false, fmt.Errorf("%s", res.Status)
+	}
+
+	blob, err = ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, 0, false, err
+	}
+
+	if cache != nil {
+		// A ttl of 0 means the upstream said not to store this response
+		// at all (Cache-Control: no-store/no-cache), which is distinct
+		// from Set's "0 means never expires" convention (used for
+		// ETags below) — so skip caching entirely rather than pass it
+		// through.
+		if ttl := cacheTTL(res.Header); ttl > 0 {
+			cache.Set(key, blob, ttl)
+			if et := res.Header.Get("ETag"); et != "" {
+				cache.Set(key+"#etag", []byte(et), 0)
+			}
+		}
+	}
+
+	return blob, 0, false, nil
+}
+
+// sleepContext is like time.Sleep but returns early with ctx.Err() if
+// ctx is done first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,110 @@
+package fandango
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	defaultRPS   = 1.0
+	defaultBurst = 1
+)
+
+// RateLimiter is a token-bucket limiter used to throttle outgoing HTTP
+// requests. The zero value is not usable; use NewRateLimiter.
+type RateLimiter struct {
+	mu         sync.Mutex
+	rps        float64
+	burst      float64
+	tokens     float64
+	lastRefill time.Time
+}
+
+// NewRateLimiter creates a RateLimiter allowing rps requests per second,
+// with up to burst requests allowed to fire back-to-back. Non-positive
+// values fall back to the defaults of 1 req/sec with a burst of 1.
+func NewRateLimiter(rps float64, burst int) *RateLimiter {
+	if rps <= 0 {
+		rps = defaultRPS
+	}
+	if burst <= 0 {
+		burst = defaultBurst
+	}
+
+	return &RateLimiter{
+		rps:        rps,
+		burst:      float64(burst),
+		tokens:     float64(burst),
+		lastRefill: time.Now(),
+	}
+}
+
+// reserve refills the bucket for elapsed time and reports how long the
+// caller must wait for a token, consuming one if already available.
+func (r *RateLimiter) reserve() time.Duration {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	r.tokens += now.Sub(r.lastRefill).Seconds() * r.rps
+	if r.tokens > r.burst {
+		r.tokens = r.burst
+	}
+	r.lastRefill = now
+
+	if r.tokens >= 1 {
+		r.tokens--
+		return 0
+	}
+
+	missing := 1 - r.tokens
+	return time.Duration(missing / r.rps * float64(time.Second))
+}
+
+// Wait blocks until a token is available.
+func (r *RateLimiter) Wait() {
+	_ = r.WaitContext(context.Background())
+}
+
+// WaitContext is like Wait but returns early with ctx.Err() if ctx is
+// done before a token becomes available.
+func (r *RateLimiter) WaitContext(ctx context.Context) error {
+	for {
+		d := r.reserve()
+		if d <= 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(d)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// SetRateLimit configures how many requests per second c's backends may
+// issue, allowing bursts of up to burst requests. It replaces the
+// default limiter of 1 req/sec with a burst of 1.
+func (c *Client) SetRateLimit(rps float64, burst int) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.rateLimiter = NewRateLimiter(rps, burst)
+}
+
+// RateLimiter returns c's configured RateLimiter, creating the default
+// one (1 req/sec, burst of 1) on first use.
+func (c *Client) RateLimiter() *RateLimiter {
+	c.Lock()
+	defer c.Unlock()
+
+	if c.rateLimiter == nil {
+		c.rateLimiter = NewRateLimiter(defaultRPS, defaultBurst)
+	}
+
+	return c.rateLimiter
+}
@@ -0,0 +1,42 @@
+package fandango
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRateLimiterReserveAllowsBurst(t *testing.T) {
+	r := NewRateLimiter(1, 2)
+
+	if d := r.reserve(); d != 0 {
+		t.Errorf("1st reserve = %v, want 0 (within burst)", d)
+	}
+	if d := r.reserve(); d != 0 {
+		t.Errorf("2nd reserve = %v, want 0 (within burst)", d)
+	}
+	if d := r.reserve(); d <= 0 {
+		t.Errorf("3rd reserve = %v, want > 0 (burst exhausted)", d)
+	}
+}
+
+func TestRateLimiterReserveWaitIsProportionalToRPS(t *testing.T) {
+	r := NewRateLimiter(2, 1)
+
+	r.reserve() // consume the only token
+
+	d := r.reserve()
+	want := 500 * time.Millisecond
+	if d < want-50*time.Millisecond || d > want+50*time.Millisecond {
+		t.Errorf("reserve() = %v, want ~%v for a 2 req/sec limiter", d, want)
+	}
+}
+
+func TestRateLimiterDefaults(t *testing.T) {
+	r := NewRateLimiter(0, 0)
+	if r.rps != defaultRPS {
+		t.Errorf("rps = %v, want %v", r.rps, defaultRPS)
+	}
+	if r.burst != float64(defaultBurst) {
+		t.Errorf("burst = %v, want %v", r.burst, float64(defaultBurst))
+	}
+}
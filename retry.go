@@ -0,0 +1,86 @@
+package fandango
+
+import (
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how cachedFetch retries failed requests. HTTP 429,
+// transient 5xx responses, and network errors are all retried; other
+// failures (4xx, bad JSON, etc.) are returned immediately.
+type RetryPolicy struct {
+	MaxRetries int
+	BaseDelay  time.Duration
+	MaxDelay   time.Duration
+}
+
+// DefaultRetryPolicy retries up to 3 times with exponential backoff
+// starting at BaseDelay and capped at MaxDelay, with jitter placing each
+// wait between half and all of that delay, honoring Retry-After when the
+// upstream sends one.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries: 3,
+	BaseDelay:  250 * time.Millisecond,
+	MaxDelay:   8 * time.Second,
+}
+
+// backoff returns how long to wait before attempt+1, preferring
+// retryAfter (parsed from the upstream's Retry-After header) when set.
+func (p RetryPolicy) backoff(attempt int, retryAfter time.Duration) time.Duration {
+	if retryAfter > 0 {
+		return retryAfter
+	}
+
+	delay := p.BaseDelay * time.Duration(uint(1)<<uint(attempt))
+	if delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+
+	return delay/2 + time.Duration(rand.Int63n(int64(delay)/2+1))
+}
+
+// SetRetryPolicy configures how c's backends retry retryable failures.
+func (c *Client) SetRetryPolicy(policy RetryPolicy) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.retryPolicy = &policy
+}
+
+// RetryPolicy returns c's configured RetryPolicy, defaulting to
+// DefaultRetryPolicy.
+func (c *Client) RetryPolicy() RetryPolicy {
+	c.RLock()
+	defer c.RUnlock()
+
+	if c.retryPolicy == nil {
+		return DefaultRetryPolicy
+	}
+
+	return *c.retryPolicy
+}
+
+func isRetryableStatus(code int) bool {
+	return code == http.StatusTooManyRequests || (code >= 500 && code <= 599)
+}
+
+// retryAfterFromHeader parses a Retry-After header, in either its
+// delta-seconds or HTTP-date form.
+func retryAfterFromHeader(header http.Header) time.Duration {
+	v := header.Get("Retry-After")
+	if v == "" {
+		return 0
+	}
+
+	if secs, err := strconv.Atoi(v); err == nil {
+		return time.Duration(secs) * time.Second
+	}
+
+	if when, err := http.ParseTime(v); err == nil {
+		return time.Until(when)
+	}
+
+	return 0
+}
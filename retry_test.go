@@ -0,0 +1,102 @@
+package fandango
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicyBackoffHonorsRetryAfter(t *testing.T) {
+	policy := RetryPolicy{BaseDelay: time.Second, MaxDelay: time.Minute}
+
+	got := policy.backoff(0, 5*time.Second)
+	if got != 5*time.Second {
+		t.Errorf("backoff with Retry-After = %v, want 5s", got)
+	}
+}
+
+func TestRetryPolicyBackoffCapsAtMaxDelay(t *testing.T) {
+	policy := RetryPolicy{BaseDelay: time.Second, MaxDelay: 2 * time.Second}
+
+	// attempt 5 would be 32s of base delay without the cap.
+	got := policy.backoff(5, 0)
+	if got > policy.MaxDelay {
+		t.Errorf("backoff = %v, want <= MaxDelay %v", got, policy.MaxDelay)
+	}
+}
+
+func TestRetryPolicyBackoffGrowsWithAttempt(t *testing.T) {
+	policy := RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Minute}
+
+	// Jitter only ever *adds* up to half the (capped) delay on top of
+	// half of it (see backoff), so attempt N+1's minimum possible value
+	// is still at least attempt N's base delay.
+	prevMinPossible := time.Duration(0)
+	for attempt := 0; attempt < 4; attempt++ {
+		delay := policy.BaseDelay * time.Duration(uint(1)<<uint(attempt))
+		minPossible := delay / 2
+		if minPossible < prevMinPossible {
+			t.Fatalf("attempt %d: minimum possible backoff %v is less than previous %v", attempt, minPossible, prevMinPossible)
+		}
+		if got := policy.backoff(attempt, 0); got < minPossible || got > delay {
+			t.Errorf("backoff(%d, 0) = %v, want between %v and %v", attempt, got, minPossible, delay)
+		}
+		prevMinPossible = minPossible
+	}
+}
+
+func TestIsRetryableStatus(t *testing.T) {
+	tests := [...]struct {
+		code int
+		want bool
+	}{
+		{http.StatusOK, false},
+		{http.StatusNotFound, false},
+		{http.StatusTooManyRequests, true},
+		{http.StatusInternalServerError, true},
+		{http.StatusBadGateway, true},
+		{599, true},
+		{600, false},
+	}
+
+	for _, tt := range tests {
+		if got := isRetryableStatus(tt.code); got != tt.want {
+			t.Errorf("isRetryableStatus(%d) = %v, want %v", tt.code, got, tt.want)
+		}
+	}
+}
+
+func TestRetryAfterFromHeader(t *testing.T) {
+	t.Run("absent", func(t *testing.T) {
+		if got := retryAfterFromHeader(make(http.Header)); got != 0 {
+			t.Errorf("retryAfterFromHeader = %v, want 0", got)
+		}
+	})
+
+	t.Run("delta-seconds", func(t *testing.T) {
+		header := make(http.Header)
+		header.Set("Retry-After", "30")
+		if got := retryAfterFromHeader(header); got != 30*time.Second {
+			t.Errorf("retryAfterFromHeader = %v, want 30s", got)
+		}
+	})
+
+	t.Run("HTTP-date", func(t *testing.T) {
+		when := time.Now().Add(time.Minute)
+		header := make(http.Header)
+		header.Set("Retry-After", when.UTC().Format(http.TimeFormat))
+
+		got := retryAfterFromHeader(header)
+		if got < 55*time.Second || got > time.Minute {
+			t.Errorf("retryAfterFromHeader = %v, want ~1m", got)
+		}
+	})
+
+	t.Run("garbage", func(t *testing.T) {
+		header := make(http.Header)
+		header.Set("Retry-After", "not-a-duration")
+		if got := retryAfterFromHeader(header); got != 0 {
+			t.Errorf("retryAfterFromHeader = %v, want 0", got)
+		}
+	})
+}
@@ -0,0 +1,293 @@
+package fandango
+
+import "context"
+
+// MovieSearch describes a free-text movie search.
+type MovieSearch struct {
+	Query string `json:"query"`
+	Year  int    `json:"year"`
+
+	// Deprecated: TMDbBackend, the only backend that implements
+	// searchBackend, returns a fixed 20 results per page and ignores
+	// this field.
+	ItemsPerPage int    `json:"page_limit"`
+	MaxPage      int    `json:"page"`
+	Country      string `json:"country"`
+
+	// Deprecated: Cancel only takes effect between throttle ticks and
+	// doesn't cancel an in-flight HTTP request. Use SearchMoviesContext
+	// instead.
+	Cancel <-chan struct{} `json:"-"`
+
+	// ForceRefresh bypasses the Client's Cache, if any.
+	ForceRefresh bool `json:"-"`
+
+	// IncludeReleaseDates has TMDbBackend fetch each movie's
+	// release_dates (and MPAA rating) inline, which costs one extra
+	// rate-limited request per movie on every page. It defaults to
+	// false; use Client.MovieByID for that detail on a single movie
+	// without paying the cost for the whole result set.
+	IncludeReleaseDates bool `json:"-"`
+}
+
+// MovieSearchResultPage is one page of MovieSearch results.
+type MovieSearchResultPage struct {
+	Total  uint     `json:"total_results"`
+	Movies []*Movie `json:"movies"`
+}
+
+// MovieSearchResult is sent on the channel returned by SearchMovies.
+// Exactly one of Page or Err is set.
+type MovieSearchResult struct {
+	Page *MovieSearchResultPage
+	Err  error
+}
+
+// PersonSearch describes a free-text search for people (actors,
+// directors, etc.).
+type PersonSearch struct {
+	Query string `json:"query"`
+
+	// Deprecated: TMDbBackend, the only backend that implements
+	// searchBackend, returns a fixed 20 results per page and ignores
+	// this field.
+	ItemsPerPage int `json:"page_limit"`
+	MaxPage      int `json:"page"`
+
+	// Deprecated: Cancel only takes effect between throttle ticks and
+	// doesn't cancel an in-flight HTTP request. Use SearchPeopleContext
+	// instead.
+	Cancel <-chan struct{} `json:"-"`
+
+	// ForceRefresh bypasses the Client's Cache, if any.
+	ForceRefresh bool `json:"-"`
+}
+
+// Person is an actor, director, or other film/TV industry figure.
+type Person struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Biography string `json:"biography"`
+	Birthday  string `json:"birthday"`
+	Photos    Poster `json:"photos"`
+
+	// Filmography maps a movie title to a link for its details.
+	Filmography LinksMap `json:"filmography"`
+}
+
+// PersonSearchResultPage is one page of PersonSearch results.
+type PersonSearchResultPage struct {
+	Total  uint      `json:"total_results"`
+	People []*Person `json:"people"`
+}
+
+// PersonSearchResult is sent on the channel returned by SearchPeople.
+// Exactly one of Page or Err is set.
+type PersonSearchResult struct {
+	Page *PersonSearchResultPage
+	Err  error
+}
+
+// TVSearch describes a free-text TV series search.
+type TVSearch struct {
+	Query string `json:"query"`
+
+	// Deprecated: TMDbBackend, the only backend that implements
+	// searchBackend, returns a fixed 20 results per page and ignores
+	// this field.
+	ItemsPerPage int `json:"page_limit"`
+	MaxPage      int `json:"page"`
+
+	// Deprecated: Cancel only takes effect between throttle ticks and
+	// doesn't cancel an in-flight HTTP request. Use TVSeriesContext
+	// instead.
+	Cancel <-chan struct{} `json:"-"`
+
+	// ForceRefresh bypasses the Client's Cache, if any.
+	ForceRefresh bool `json:"-"`
+}
+
+// Episode is a single episode of a Season.
+type Episode struct {
+	EpisodeNumber  int     `json:"episode_number"`
+	Name           string  `json:"name"`
+	AirDate        string  `json:"air_date"`
+	Synopsis       string  `json:"synopsis"`
+	RuntimeMinutes float32 `json:"runtime"`
+}
+
+// Season is a single season of a TVSeries.
+type Season struct {
+	SeasonNumber int        `json:"season_number"`
+	Name         string     `json:"name"`
+	AirDate      string     `json:"air_date"`
+	Episodes     []*Episode `json:"episodes"`
+}
+
+// TVSeries is a television series, with its full season/episode listing.
+type TVSeries struct {
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	Synopsis     string    `json:"synopsis"`
+	FirstAirDate string    `json:"first_air_date"`
+	Posters      Poster    `json:"posters"`
+	Seasons      []*Season `json:"seasons"`
+}
+
+// TVSeriesResultPage is one page of TVSearch results.
+type TVSeriesResultPage struct {
+	Total  uint        `json:"total_results"`
+	Series []*TVSeries `json:"series"`
+}
+
+// TVSeriesResult is sent on the channel returned by TVSeries. Exactly
+// one of Page or Err is set.
+type TVSeriesResult struct {
+	Page *TVSeriesResultPage
+	Err  error
+}
+
+// MovieDetails extends Movie with the fields only available from a
+// single-movie lookup (MovieByID), as opposed to a list/search result.
+type MovieDetails struct {
+	Movie
+
+	Budget              int64    `json:"budget"`
+	Revenue             int64    `json:"revenue"`
+	Genres              []string `json:"genres"`
+	ProductionCompanies []string `json:"production_companies"`
+	Keywords            []string `json:"keywords"`
+
+	// Videos maps a video's name (e.g. "Official Trailer") to its URL.
+	Videos LinksMap `json:"videos"`
+
+	// Similar and Recommendations map a related movie's title to a link
+	// for its details.
+	Similar         LinksMap `json:"similar"`
+	Recommendations LinksMap `json:"recommendations"`
+}
+
+// searchBackend is implemented by backends that expose movie/person/TV
+// search and by-ID lookups in addition to list methods. RottenTomatoesBackend
+// doesn't implement it, so Client.SearchMovies et al. report
+// errUnsupportedByBackend when it is the configured backend.
+type searchBackend interface {
+	SearchMovies(c *Client, query *MovieSearch) (<-chan MovieSearchResult, error)
+	SearchMoviesContext(ctx context.Context, c *Client, query *MovieSearch) (<-chan MovieSearchResult, error)
+
+	SearchPeople(c *Client, query *PersonSearch) (<-chan PersonSearchResult, error)
+	SearchPeopleContext(ctx context.Context, c *Client, query *PersonSearch) (<-chan PersonSearchResult, error)
+
+	MovieByIDContext(ctx context.Context, c *Client, id string) (*MovieDetails, error)
+	PersonByIDContext(ctx context.Context, c *Client, id string) (*Person, error)
+
+	TVSeries(c *Client, query *TVSearch) (<-chan TVSeriesResult, error)
+	TVSeriesContext(ctx context.Context, c *Client, query *TVSearch) (<-chan TVSeriesResult, error)
+}
+
+// SearchMovies streams pages of movies matching query. It requires a
+// backend that implements searchBackend (TMDbBackend does).
+func (c *Client) SearchMovies(query *MovieSearch) (<-chan MovieSearchResult, error) {
+	return c.SearchMoviesContext(context.Background(), query)
+}
+
+// SearchMoviesContext is like SearchMovies but cancels in-flight requests
+// as soon as ctx is done.
+func (c *Client) SearchMoviesContext(ctx context.Context, query *MovieSearch) (<-chan MovieSearchResult, error) {
+	if c.APIKey() == "" {
+		return nil, errEmptyAPIKey
+	}
+
+	sb, ok := c.Backend().(searchBackend)
+	if !ok {
+		return nil, errUnsupportedByBackend
+	}
+
+	return sb.SearchMoviesContext(ctx, c, query)
+}
+
+// SearchPeople streams pages of people matching query. It requires a
+// backend that implements searchBackend (TMDbBackend does).
+func (c *Client) SearchPeople(query *PersonSearch) (<-chan PersonSearchResult, error) {
+	return c.SearchPeopleContext(context.Background(), query)
+}
+
+// SearchPeopleContext is like SearchPeople but cancels in-flight requests
+// as soon as ctx is done.
+func (c *Client) SearchPeopleContext(ctx context.Context, query *PersonSearch) (<-chan PersonSearchResult, error) {
+	if c.APIKey() == "" {
+		return nil, errEmptyAPIKey
+	}
+
+	sb, ok := c.Backend().(searchBackend)
+	if !ok {
+		return nil, errUnsupportedByBackend
+	}
+
+	return sb.SearchPeopleContext(ctx, c, query)
+}
+
+// MovieByID looks up a single movie's full details by its backend ID. It
+// requires a backend that implements searchBackend (TMDbBackend does).
+func (c *Client) MovieByID(id string) (*MovieDetails, error) {
+	return c.MovieByIDContext(context.Background(), id)
+}
+
+// MovieByIDContext is like MovieByID but cancels the request as soon as
+// ctx is done.
+func (c *Client) MovieByIDContext(ctx context.Context, id string) (*MovieDetails, error) {
+	if c.APIKey() == "" {
+		return nil, errEmptyAPIKey
+	}
+
+	sb, ok := c.Backend().(searchBackend)
+	if !ok {
+		return nil, errUnsupportedByBackend
+	}
+
+	return sb.MovieByIDContext(ctx, c, id)
+}
+
+// PersonByID looks up a single person's details, including filmography,
+// by their backend ID. It requires a backend that implements
+// searchBackend (TMDbBackend does).
+func (c *Client) PersonByID(id string) (*Person, error) {
+	return c.PersonByIDContext(context.Background(), id)
+}
+
+// PersonByIDContext is like PersonByID but cancels the request as soon as
+// ctx is done.
+func (c *Client) PersonByIDContext(ctx context.Context, id string) (*Person, error) {
+	if c.APIKey() == "" {
+		return nil, errEmptyAPIKey
+	}
+
+	sb, ok := c.Backend().(searchBackend)
+	if !ok {
+		return nil, errUnsupportedByBackend
+	}
+
+	return sb.PersonByIDContext(ctx, c, id)
+}
+
+// TVSeries streams pages of TV series matching query, each with its full
+// season/episode listing. It requires a backend that implements
+// searchBackend (TMDbBackend does).
+func (c *Client) TVSeries(query *TVSearch) (<-chan TVSeriesResult, error) {
+	return c.TVSeriesContext(context.Background(), query)
+}
+
+// TVSeriesContext is like TVSeries but cancels in-flight requests as soon
+// as ctx is done.
+func (c *Client) TVSeriesContext(ctx context.Context, query *TVSearch) (<-chan TVSeriesResult, error) {
+	if c.APIKey() == "" {
+		return nil, errEmptyAPIKey
+	}
+
+	sb, ok := c.Backend().(searchBackend)
+	if !ok {
+		return nil, errUnsupportedByBackend
+	}
+
+	return sb.TVSeriesContext(ctx, c, query)
+}
@@ -0,0 +1,224 @@
+package fandango
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// MovieDiff records the fields that changed between two observations of
+// the same movie (matched by movieKey), e.g. a shifted release date or
+// an edited synopsis.
+type MovieDiff struct {
+	Before  *Movie   `json:"before"`
+	After   *Movie   `json:"after"`
+	Changes []string `json:"changes"`
+}
+
+// WatchEvent is sent on the channel returned by WatchUpcoming whenever a
+// poll detects a change in the movie list. Err is set instead of the
+// other fields if a poll fails; the watch continues on the next tick.
+type WatchEvent struct {
+	Added   []*Movie     `json:"added"`
+	Removed []*Movie     `json:"removed"`
+	Updated []*MovieDiff `json:"updated"`
+	Err     error        `json:"-"`
+}
+
+func (e *WatchEvent) empty() bool {
+	return len(e.Added) == 0 && len(e.Removed) == 0 && len(e.Updated) == 0
+}
+
+// movieKey returns a stable identifier for m, preferring its "self" link
+// (present in list responses) and falling back to title+year for
+// backends or fixtures that don't populate Links.
+func movieKey(m *Movie) string {
+	if self := m.Links["self"]; self != "" {
+		return self
+	}
+
+	return fmt.Sprintf("%s|%d", m.Title, m.Year)
+}
+
+// WatchUpcoming polls UpcomingMoviesContext every interval and streams a
+// WatchEvent each time the result differs from the previous poll: movies
+// that appeared, movies that dropped off the list, and movies whose
+// fields changed in place. The watch stops, closing the channel, when
+// ctx is done.
+//
+// If c has a Cache configured, the last-seen snapshot is persisted
+// through it, so a new WatchUpcoming (even in a different process) picks
+// up diffing from where the last one left off instead of reporting every
+// movie as added on its first poll.
+func (c *Client) WatchUpcoming(ctx context.Context, query *UpcomingMovieSearch, interval time.Duration) (<-chan WatchEvent, error) {
+	if c.APIKey() == "" {
+		return nil, errEmptyAPIKey
+	}
+
+	eventsChan := make(chan WatchEvent)
+	go func() {
+		defer close(eventsChan)
+
+		snapshotKey := watchSnapshotKey(query)
+		prev := c.loadSnapshot(snapshotKey)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			curr, err := c.collectUpcoming(ctx, query)
+			if err != nil {
+				select {
+				case <-ctx.Done():
+					return
+				case eventsChan <- WatchEvent{Err: err}:
+				}
+			} else {
+				event := diffMovies(prev, curr)
+				if !event.empty() {
+					select {
+					case <-ctx.Done():
+						return
+					case eventsChan <- event:
+					}
+				}
+
+				prev = curr
+				c.saveSnapshot(snapshotKey, curr)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return eventsChan, nil
+}
+
+// collectUpcoming drains UpcomingMoviesContext into a single map keyed by
+// movieKey.
+func (c *Client) collectUpcoming(ctx context.Context, query *UpcomingMovieSearch) (map[string]*Movie, error) {
+	pagesChan, err := c.UpcomingMoviesContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	movies := make(map[string]*Movie)
+	for result := range pagesChan {
+		if result.Err != nil {
+			return nil, result.Err
+		}
+		for _, movie := range result.Page.Movies {
+			movies[movieKey(movie)] = movie
+		}
+	}
+
+	return movies, nil
+}
+
+// diffMovies compares two observations of a movie list and reports what
+// changed.
+func diffMovies(prev, curr map[string]*Movie) WatchEvent {
+	var event WatchEvent
+
+	for key, movie := range curr {
+		prevMovie, ok := prev[key]
+		if !ok {
+			event.Added = append(event.Added, movie)
+			continue
+		}
+
+		if changes := movieChanges(prevMovie, movie); len(changes) > 0 {
+			event.Updated = append(event.Updated, &MovieDiff{
+				Before:  prevMovie,
+				After:   movie,
+				Changes: changes,
+			})
+		}
+	}
+
+	for key, movie := range prev {
+		if _, ok := curr[key]; !ok {
+			event.Removed = append(event.Removed, movie)
+		}
+	}
+
+	return event
+}
+
+// movieChanges returns the names of the Movie fields that differ between
+// before and after.
+func movieChanges(before, after *Movie) []string {
+	var changes []string
+
+	if before.Title != after.Title {
+		changes = append(changes, "Title")
+	}
+	if before.Year != after.Year {
+		changes = append(changes, "Year")
+	}
+	if before.MPAARating != after.MPAARating {
+		changes = append(changes, "MPAARating")
+	}
+	if before.RuntimeMinutes != after.RuntimeMinutes {
+		changes = append(changes, "RuntimeMinutes")
+	}
+	if before.CriticsConsensus != after.CriticsConsensus {
+		changes = append(changes, "CriticsConsensus")
+	}
+	if before.Synopsis != after.Synopsis {
+		changes = append(changes, "Synopsis")
+	}
+	if !reflect.DeepEqual(before.ReleaseDates, after.ReleaseDates) {
+		changes = append(changes, "ReleaseDates")
+	}
+
+	return changes
+}
+
+func watchSnapshotKey(query *UpcomingMovieSearch) string {
+	country := ""
+	if query != nil {
+		country = query.Country
+	}
+
+	return fmt.Sprintf("watch:upcoming:%s", country)
+}
+
+func (c *Client) loadSnapshot(key string) map[string]*Movie {
+	cache := c.Cache()
+	if cache == nil {
+		return nil
+	}
+
+	blob, ok, _ := cache.Get(key)
+	if !ok {
+		return nil
+	}
+
+	var movies map[string]*Movie
+	if err := json.Unmarshal(blob, &movies); err != nil {
+		return nil
+	}
+
+	return movies
+}
+
+func (c *Client) saveSnapshot(key string, movies map[string]*Movie) {
+	cache := c.Cache()
+	if cache == nil {
+		return
+	}
+
+	blob, err := json.Marshal(movies)
+	if err != nil {
+		return
+	}
+
+	cache.Set(key, blob, 0)
+}
@@ -0,0 +1,83 @@
+package fandango
+
+import "testing"
+
+func TestMovieKeyPrefersSelfLink(t *testing.T) {
+	m := &Movie{
+		Title: "Dune",
+		Year:  2021,
+		Links: LinksMap{"self": "https://api.themoviedb.org/3/movie/438631"},
+	}
+
+	if got, want := movieKey(m), "https://api.themoviedb.org/3/movie/438631"; got != want {
+		t.Errorf("movieKey = %q, want %q", got, want)
+	}
+}
+
+func TestMovieKeyFallsBackToTitleYear(t *testing.T) {
+	m := &Movie{Title: "Dune", Year: 2021}
+
+	if got, want := movieKey(m), "Dune|2021"; got != want {
+		t.Errorf("movieKey = %q, want %q", got, want)
+	}
+}
+
+func TestDiffMoviesAdded(t *testing.T) {
+	curr := map[string]*Movie{"a": {Title: "A"}}
+
+	event := diffMovies(nil, curr)
+	if len(event.Added) != 1 || event.Added[0].Title != "A" {
+		t.Errorf("Added = %+v, want [A]", event.Added)
+	}
+	if len(event.Removed) != 0 || len(event.Updated) != 0 {
+		t.Errorf("Removed/Updated should be empty, got %+v / %+v", event.Removed, event.Updated)
+	}
+}
+
+func TestDiffMoviesRemoved(t *testing.T) {
+	prev := map[string]*Movie{"a": {Title: "A"}}
+
+	event := diffMovies(prev, map[string]*Movie{})
+	if len(event.Removed) != 1 || event.Removed[0].Title != "A" {
+		t.Errorf("Removed = %+v, want [A]", event.Removed)
+	}
+	if len(event.Added) != 0 || len(event.Updated) != 0 {
+		t.Errorf("Added/Updated should be empty, got %+v / %+v", event.Added, event.Updated)
+	}
+}
+
+func TestDiffMoviesUpdated(t *testing.T) {
+	prev := map[string]*Movie{"a": {Title: "A", Synopsis: "old"}}
+	curr := map[string]*Movie{"a": {Title: "A", Synopsis: "new"}}
+
+	event := diffMovies(prev, curr)
+	if len(event.Updated) != 1 {
+		t.Fatalf("Updated = %+v, want 1 entry", event.Updated)
+	}
+	if got := event.Updated[0].Changes; len(got) != 1 || got[0] != "Synopsis" {
+		t.Errorf("Changes = %v, want [Synopsis]", got)
+	}
+	if len(event.Added) != 0 || len(event.Removed) != 0 {
+		t.Errorf("Added/Removed should be empty, got %+v / %+v", event.Added, event.Removed)
+	}
+}
+
+func TestDiffMoviesUnchangedIsEmpty(t *testing.T) {
+	prev := map[string]*Movie{"a": {Title: "A"}}
+	curr := map[string]*Movie{"a": {Title: "A"}}
+
+	event := diffMovies(prev, curr)
+	if !event.empty() {
+		t.Errorf("event = %+v, want empty", event)
+	}
+}
+
+func TestMovieChangesDetectsReleaseDates(t *testing.T) {
+	before := &Movie{ReleaseDates: map[string]string{"theatrical": "2026-01-01"}}
+	after := &Movie{ReleaseDates: map[string]string{"theatrical": "2026-02-01"}}
+
+	changes := movieChanges(before, after)
+	if len(changes) != 1 || changes[0] != "ReleaseDates" {
+		t.Errorf("changes = %v, want [ReleaseDates]", changes)
+	}
+}